@@ -0,0 +1,104 @@
+package testlicense
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+)
+
+// ApprovalSet selects which approval lists AssertApprovedLicense accepts.
+type ApprovalSet int
+
+const (
+	// ApprovalFSF accepts any license on the FSF free-software license
+	// list.
+	ApprovalFSF ApprovalSet = 1 << iota
+
+	// ApprovalOSI accepts any license on the OSI open-source license list.
+	ApprovalOSI
+
+	// ApprovalAny accepts a license approved by either FSF or OSI.
+	ApprovalAny = ApprovalFSF | ApprovalOSI
+)
+
+// approval records whether a license is FSF- and/or OSI-approved.
+//
+// This table is a hand-curated subset of the SPDX license list JSON
+// (https://spdx.org/licenses/) and can be regenerated from it as new
+// licenses are added. It is keyed by the license name reported in
+// Match.Name, not licensecheck.Type: Type is a coarse enum (Apache, BSD,
+// GPL, ...) that cannot distinguish, say, BSD-2-Clause from BSD-3-Clause or
+// GPL-2.0 from GPL-3.0, while Match.Name carries the specific identifier
+// licensecheck matched against.
+type approval struct {
+	FSF bool
+	OSI bool
+}
+
+var approvedLicenses = map[string]approval{
+	"MIT":          {FSF: true, OSI: true},
+	"Apache-2.0":   {FSF: true, OSI: true},
+	"BSD-2-Clause": {FSF: true, OSI: true},
+	"BSD-3-Clause": {FSF: true, OSI: true},
+	"GPL-2.0":      {FSF: true, OSI: true},
+	"GPL-3.0":      {FSF: true, OSI: true},
+	"LGPL-2.1":     {FSF: true, OSI: true},
+	"LGPL-3.0":     {FSF: true, OSI: true},
+	"AGPL-3.0":     {FSF: true, OSI: true},
+	"MPL-2.0":      {FSF: true, OSI: true},
+	"ISC":          {FSF: true, OSI: true},
+	"Unlicense":    {FSF: true, OSI: true},
+	"Zlib":         {FSF: true, OSI: true},
+}
+
+// TestApprovedLicense calls t.Fatal(err) if the license in the current
+// directory is not FSF- and/or OSI-approved per approvers, or percentage is
+// less than percent.
+func TestApprovedLicense(t *testing.T, percent float64, approvers ApprovalSet) {
+	if err := AssertApprovedLicense(percent, approvers); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// AssertApprovedLicense returns err != nil unless the license in the
+// current directory is covered at >= percent by a license that approvers
+// recognizes as FSF- and/or OSI-approved, rather than requiring an exact
+// match to one licensecheck.Type.
+func AssertApprovedLicense(percent float64, approvers ApprovalSet) error {
+	return AssertApprovedLicenseFS(os.DirFS("."), percent, approvers)
+}
+
+// AssertApprovedLicenseFS returns err != nil unless the license in fsys is
+// covered at >= percent by a license that approvers recognizes as FSF-
+// and/or OSI-approved, rather than requiring an exact match to one
+// licensecheck.Type.
+//
+// fsys may be any fs.FS, such as a subtree of a zip archive, a vendored
+// module, or an embed.FS snapshot of the module obtained via fs.Sub.
+func AssertApprovedLicenseFS(fsys fs.FS, percent float64, approvers ApprovalSet) error {
+	res, err := Inspect(fsys)
+	if err != nil {
+		return err
+	}
+
+	var found []string
+	for _, m := range res.Matches {
+		found = append(found, fmt.Sprintf("%s(%3.1f%%)", m.Name, m.Percent))
+		if m.Percent < percent {
+			continue
+		}
+		a, ok := approvedLicenses[m.Name]
+		if !ok {
+			continue
+		}
+		if approvers&ApprovalFSF != 0 && a.FSF {
+			return nil
+		}
+		if approvers&ApprovalOSI != 0 && a.OSI {
+			return nil
+		}
+	}
+	return fmt.Errorf("no FSF/OSI-approved license found at >= %g%%; found: %s", percent, strings.Join(found, ","))
+}
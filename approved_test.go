@@ -0,0 +1,24 @@
+package testlicense
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestAssertApprovedLicenseFSAcceptsMIT(t *testing.T) {
+	fsys := fstest.MapFS{
+		"LICENSE": &fstest.MapFile{Data: []byte(mitLicenseText)},
+	}
+	if err := AssertApprovedLicenseFS(fsys, 90, ApprovalAny); err != nil {
+		t.Fatalf("AssertApprovedLicenseFS: %v", err)
+	}
+}
+
+func TestAssertApprovedLicenseFSRejectsUnrecognizedText(t *testing.T) {
+	fsys := fstest.MapFS{
+		"LICENSE": &fstest.MapFile{Data: []byte("This is just some text. It is not a recognizable open source license at all.")},
+	}
+	if err := AssertApprovedLicenseFS(fsys, 90, ApprovalAny); err == nil {
+		t.Fatal("expected error for unrecognized license text")
+	}
+}
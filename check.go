@@ -18,9 +18,8 @@
 package testlicense
 
 import (
-	"errors"
 	"fmt"
-	"io/ioutil"
+	"io/fs"
 	"os"
 	"strings"
 	"testing"
@@ -119,33 +118,42 @@ func TestPercent(t *testing.T, want licensecheck.Type, percent float64) {
 // AssertLicense returns err != nil if the license in the current directory
 // does not match want or percentage is less than percent.
 func AssertLicense(want licensecheck.Type, percent float64) error {
-	_, b, err := ReadLicense()
-	if err != nil {
-		return err
-	}
-	return assertLicense(b, want, percent)
+	return AssertLicenseFS(os.DirFS("."), want, percent)
 }
 
 // AssertLicenseDir returns err != nil if the license in the dir does not match
 // want or percentage is less than percent.
 func AssertLicenseDir(dir DirnamesReader, want licensecheck.Type, percent float64) error {
-	_, b, err := ReadLicenseDir(dir)
+	filename, b, err := ReadLicenseDir(dir)
+	if err != nil {
+		return err
+	}
+	res, err := inspectContents(filename, b)
 	if err != nil {
 		return err
 	}
-	return assertLicense(b, want, percent)
+	return assertResult(res, want, percent)
 }
 
-func assertLicense(b []byte, want licensecheck.Type, percent float64) error {
-	cov, ok := licensecheck.Cover(b, licensecheck.Options{})
-	if !ok {
-		return errors.New("license not found")
+// AssertLicenseFS returns err != nil if the license in fsys does not match
+// want or percentage is less than percent.
+//
+// fsys may be any fs.FS, such as a subtree of a zip archive, a vendored
+// module, or an embed.FS snapshot of the module obtained via fs.Sub.
+func AssertLicenseFS(fsys fs.FS, want licensecheck.Type, percent float64) error {
+	res, err := Inspect(fsys)
+	if err != nil {
+		return err
 	}
-	if cov.Percent < percent {
-		return fmt.Errorf("percentage %f is less than wanted %f", cov.Percent, percent)
+	return assertResult(res, want, percent)
+}
+
+func assertResult(res *Result, want licensecheck.Type, percent float64) error {
+	if res.Coverage.Percent < percent {
+		return fmt.Errorf("percentage %f is less than wanted %f", res.Coverage.Percent, percent)
 	}
 	var list []string
-	for _, m := range cov.Match {
+	for _, m := range res.Matches {
 		if m.Type == want {
 			return nil
 		}
@@ -154,15 +162,10 @@ func assertLicense(b []byte, want licensecheck.Type, percent float64) error {
 	return fmt.Errorf("license does not match. found: %s", strings.Join(list, ","))
 }
 
-// ReadLicenseDir searchs the license file in the current dir and returns the
+// ReadLicense searchs the license file in the current dir and returns the
 // filename and the contents.
 func ReadLicense() (filename string, contents []byte, err error) {
-	f, err := os.Open(".")
-	if err != nil {
-		return "", nil, err
-	}
-	defer f.Close()
-	return ReadLicenseDir(f)
+	return ReadLicenseFS(os.DirFS("."))
 }
 
 type DirnamesReader interface {
@@ -178,9 +181,25 @@ func ReadLicenseDir(dir DirnamesReader) (filename string, contents []byte, err e
 	}
 	for _, filename = range names {
 		if IsLicenseFilename(filename) {
-			b, err := ioutil.ReadFile(filename)
+			b, err := os.ReadFile(filename)
 			return filename, b, err
 		}
 	}
 	return "", nil, os.ErrNotExist
 }
+
+// ReadLicenseFS searchs the license file in fsys and returns the filename and
+// the contents.
+func ReadLicenseFS(fsys fs.FS) (filename string, contents []byte, err error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return "", nil, err
+	}
+	for _, entry := range entries {
+		if IsLicenseFilename(entry.Name()) {
+			b, err := fs.ReadFile(fsys, entry.Name())
+			return entry.Name(), b, err
+		}
+	}
+	return "", nil, os.ErrNotExist
+}
@@ -0,0 +1,186 @@
+package testlicense
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/licensecheck"
+)
+
+// DepPolicy describes which licenses are acceptable for the current
+// module's dependencies.
+type DepPolicy struct {
+	// Percent is the minimum coverage percent required to trust a license
+	// classification. Defaults to 90.
+	Percent float64
+
+	// Allow lists the license types accepted for any dependency not
+	// covered by Overrides. A nil or empty Allow accepts every type not
+	// listed in Deny.
+	Allow []licensecheck.Type
+
+	// Deny lists license types that are always rejected, even if present
+	// in Allow or an override.
+	Deny []licensecheck.Type
+
+	// Overrides replaces Allow for the named module path, e.g. to permit a
+	// GPL-licensed tool dependency that is not linked into the binary.
+	Overrides map[string][]licensecheck.Type
+}
+
+// DepLicense reports the license found for one dependency module.
+type DepLicense struct {
+	Module   string
+	Version  string
+	Path     string
+	Licenses []licensecheck.Match
+	Percent  float64
+}
+
+// AssertDependencyLicenses resolves the current module's dependency graph
+// with `go list -m -json all`, locates each dependency's license file in
+// the module cache, classifies it with licensecheck.Cover, and enforces
+// policy. It returns the bill of materials alongside the error so callers
+// can render a report even when the check fails. The report holds only the
+// dependencies whose license file was found and recognized; a dependency
+// without one is named in the error instead, and a module that has not been
+// downloaded (no module cache directory) is skipped.
+func AssertDependencyLicenses(policy DepPolicy) ([]DepLicense, error) {
+	if policy.Percent == 0 {
+		policy.Percent = 90
+	}
+
+	mods, err := goListModules()
+	if err != nil {
+		return nil, err
+	}
+
+	var report []DepLicense
+	var violations []string
+	for _, mod := range mods {
+		if mod.Main || mod.Dir == "" {
+			continue
+		}
+		dep, err := inspectModule(mod)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("%s@%s: %s", mod.Path, mod.Version, err))
+			continue
+		}
+		report = append(report, dep)
+		if err := policy.check(dep); err != nil {
+			violations = append(violations, fmt.Sprintf("%s@%s: %s", mod.Path, mod.Version, err))
+		}
+	}
+
+	if len(violations) > 0 {
+		return report, fmt.Errorf("dependency license policy violated:\n%s", strings.Join(violations, "\n"))
+	}
+	return report, nil
+}
+
+func (p DepPolicy) check(dep DepLicense) error {
+	allow := p.Allow
+	if o, ok := p.Overrides[dep.Module]; ok {
+		allow = o
+	}
+
+	if dep.Percent < p.Percent {
+		return fmt.Errorf("license coverage %g%% is less than required %g%%", dep.Percent, p.Percent)
+	}
+
+	for _, m := range dep.Licenses {
+		if typeIn(m.Type, p.Deny) {
+			return fmt.Errorf("license %s is denied", m.Type)
+		}
+	}
+	if len(allow) == 0 {
+		return nil
+	}
+	for _, m := range dep.Licenses {
+		if typeIn(m.Type, allow) {
+			return nil
+		}
+	}
+	return fmt.Errorf("no allowed license found, got %s", formatMatches(dep.Licenses))
+}
+
+func typeIn(t licensecheck.Type, list []licensecheck.Type) bool {
+	for _, v := range list {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+func formatMatches(matches []licensecheck.Match) string {
+	var parts []string
+	for _, m := range matches {
+		parts = append(parts, fmt.Sprintf("%s(%3.1f%%)", m.Name, m.Percent))
+	}
+	return strings.Join(parts, ",")
+}
+
+func inspectModule(mod goModule) (DepLicense, error) {
+	entries, err := os.ReadDir(mod.Dir)
+	if err != nil {
+		return DepLicense{}, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !IsLicenseFilename(entry.Name()) {
+			continue
+		}
+		path := filepath.Join(mod.Dir, entry.Name())
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return DepLicense{}, err
+		}
+		cov, ok := licensecheck.Cover(b, licensecheck.Options{})
+		if !ok {
+			return DepLicense{}, fmt.Errorf("license file %s found but not recognized", entry.Name())
+		}
+		return DepLicense{
+			Module:   mod.Path,
+			Version:  mod.Version,
+			Path:     path,
+			Licenses: cov.Match,
+			Percent:  cov.Percent,
+		}, nil
+	}
+	return DepLicense{}, fmt.Errorf("no license file found in %s", mod.Dir)
+}
+
+// goModule mirrors the subset of `go list -m -json` output this package
+// needs.
+type goModule struct {
+	Path    string
+	Version string
+	Dir     string
+	Main    bool
+}
+
+func goListModules() ([]goModule, error) {
+	cmd := exec.Command("go", "list", "-m", "-json", "all")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("go list -m -json all: %w: %s", err, stderr.String())
+	}
+
+	var mods []goModule
+	dec := json.NewDecoder(&stdout)
+	for dec.More() {
+		var mod goModule
+		if err := dec.Decode(&mod); err != nil {
+			return nil, err
+		}
+		mods = append(mods, mod)
+	}
+	return mods, nil
+}
@@ -0,0 +1,118 @@
+package testlicense
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+)
+
+// exprOp is the boolean operator joining the children of an exprNode.
+type exprOp int
+
+const (
+	opNone exprOp = iota
+	opAnd
+	opOr
+)
+
+// exprNode is a node of a boolean license expression such as
+// "MIT OR Apache-2.0" or "(MIT OR BSD-3-Clause) AND Unlicense".
+//
+// A node is either a leaf naming a license, in which case Op is opNone and
+// Children is empty, or an AND/OR node with two or more Children.
+type exprNode struct {
+	Name     string
+	Op       exprOp
+	Children []*exprNode
+}
+
+func (n *exprNode) String() string {
+	if n.Op == opNone {
+		return n.Name
+	}
+	sep := " AND "
+	if n.Op == opOr {
+		sep = " OR "
+	}
+	parts := make([]string, len(n.Children))
+	for i, c := range n.Children {
+		parts[i] = c.String()
+	}
+	return "(" + strings.Join(parts, sep) + ")"
+}
+
+// TestExpr calls t.Fatal(err) if the license in the current directory does
+// not satisfy expr or percentage is less than percent.
+func TestExpr(t *testing.T, expr string, percent float64) {
+	if err := AssertLicenseExpr(expr, percent); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// AssertLicenseExpr returns err != nil if the license in the current
+// directory does not satisfy the boolean license expression expr, such as
+// "MIT OR Apache-2.0", or if no license in the expression reaches percent
+// coverage.
+func AssertLicenseExpr(expr string, percent float64) error {
+	return AssertLicenseExprFS(os.DirFS("."), expr, percent)
+}
+
+// AssertLicenseExprFS returns err != nil if the license in fsys does not
+// satisfy the boolean license expression expr, such as "MIT OR Apache-2.0",
+// or if no license in the expression reaches percent coverage.
+//
+// fsys may be any fs.FS, such as a subtree of a zip archive, a vendored
+// module, or an embed.FS snapshot of the module obtained via fs.Sub.
+func AssertLicenseExprFS(fsys fs.FS, expr string, percent float64) error {
+	node, err := parseExpr(expr)
+	if err != nil {
+		return err
+	}
+	res, err := Inspect(fsys)
+	if err != nil {
+		return err
+	}
+	if ok, failed := evalExpr(node, res.Matches, percent); !ok {
+		var found []string
+		for _, m := range res.Matches {
+			found = append(found, fmt.Sprintf("%s(%3.1f%%)", m.Name, m.Percent))
+		}
+		return fmt.Errorf("license expression %q not satisfied: %s not found at >= %g%%; found: %s", expr, failed, percent, strings.Join(found, ","))
+	}
+	return nil
+}
+
+// evalExpr reports whether node is satisfied by matches at the given
+// percent threshold. On failure it also returns the sub-expression that
+// could not be satisfied.
+func evalExpr(node *exprNode, matches []Match, percent float64) (bool, string) {
+	switch node.Op {
+	case opNone:
+		for _, m := range matches {
+			if m.Name == node.Name && m.Percent >= percent {
+				return true, ""
+			}
+		}
+		return false, node.Name
+	case opAnd:
+		for _, c := range node.Children {
+			if ok, failed := evalExpr(c, matches, percent); !ok {
+				return false, failed
+			}
+		}
+		return true, ""
+	case opOr:
+		var failed string
+		for _, c := range node.Children {
+			if ok, f := evalExpr(c, matches, percent); ok {
+				return true, ""
+			} else if failed == "" {
+				failed = f
+			}
+		}
+		return false, node.String()
+	}
+	return false, node.String()
+}
@@ -0,0 +1,46 @@
+package testlicense
+
+import "testing"
+
+func TestParseExprAndEval(t *testing.T) {
+	cases := []struct {
+		expr    string
+		matches []Match
+		percent float64
+		want    bool
+	}{
+		{"MIT", []Match{{Name: "MIT", Percent: 100}}, 90, true},
+		{"MIT", []Match{{Name: "MIT", Percent: 50}}, 90, false},
+		{"MIT OR Apache-2.0", []Match{{Name: "Apache-2.0", Percent: 95}}, 90, true},
+		{"MIT OR Apache-2.0", []Match{{Name: "BSD-3-Clause", Percent: 100}}, 90, false},
+		{
+			"(MIT OR BSD-3-Clause) AND Unlicense",
+			[]Match{{Name: "MIT", Percent: 100}, {Name: "Unlicense", Percent: 100}},
+			90,
+			true,
+		},
+		{
+			"(MIT OR BSD-3-Clause) AND Unlicense",
+			[]Match{{Name: "MIT", Percent: 100}},
+			90,
+			false,
+		},
+	}
+	for _, c := range cases {
+		node, err := parseExpr(c.expr)
+		if err != nil {
+			t.Fatalf("parseExpr(%q): %v", c.expr, err)
+		}
+		if ok, _ := evalExpr(node, c.matches, c.percent); ok != c.want {
+			t.Errorf("evalExpr(%q, %v) = %v, want %v", c.expr, c.matches, ok, c.want)
+		}
+	}
+}
+
+func TestParseExprErrors(t *testing.T) {
+	for _, expr := range []string{"", "(MIT", "MIT)", "AND MIT", "MIT OR", "MIT AND"} {
+		if _, err := parseExpr(expr); err == nil {
+			t.Errorf("parseExpr(%q): expected error, got nil", expr)
+		}
+	}
+}
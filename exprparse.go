@@ -0,0 +1,152 @@
+package testlicense
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parseExpr parses a boolean license expression such as
+// "MIT OR Apache-2.0" or "(MIT OR BSD-3-Clause) AND Unlicense" into an
+// exprNode tree.
+//
+// Grammar:
+//
+//	expr   = term { "OR" term }
+//	term   = factor { "AND" factor }
+//	factor = name | "(" expr ")"
+//	name   = [A-Za-z0-9.+-]+
+func parseExpr(s string) (*exprNode, error) {
+	p := &exprParser{tokens: tokenizeExpr(s)}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("license expression: unexpected token %q", p.tokens[p.pos])
+	}
+	return node, nil
+}
+
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *exprParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *exprParser) parseOr() (*exprNode, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	children := []*exprNode{first}
+	for strings.EqualFold(p.peek(), "OR") {
+		p.next()
+		n, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, n)
+	}
+	if len(children) == 1 {
+		return children[0], nil
+	}
+	return &exprNode{Op: opOr, Children: children}, nil
+}
+
+func (p *exprParser) parseAnd() (*exprNode, error) {
+	first, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	children := []*exprNode{first}
+	for strings.EqualFold(p.peek(), "AND") {
+		p.next()
+		n, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, n)
+	}
+	if len(children) == 1 {
+		return children[0], nil
+	}
+	return &exprNode{Op: opAnd, Children: children}, nil
+}
+
+func (p *exprParser) parseFactor() (*exprNode, error) {
+	tok := p.peek()
+	switch {
+	case tok == "":
+		return nil, fmt.Errorf("license expression: unexpected end of expression")
+	case tok == "(":
+		p.next()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("license expression: missing closing paren")
+		}
+		p.next()
+		return node, nil
+	case tok == ")":
+		return nil, fmt.Errorf("license expression: unexpected %q", tok)
+	case strings.EqualFold(tok, "AND"), strings.EqualFold(tok, "OR"):
+		return nil, fmt.Errorf("license expression: unexpected operator %q", tok)
+	default:
+		p.next()
+		return &exprNode{Name: tok}, nil
+	}
+}
+
+// tokenizeExpr splits s into identifiers matching [A-Za-z0-9.+-]+, the
+// keywords AND/OR, and parentheses.
+func tokenizeExpr(s string) []string {
+	var tokens []string
+	var cur strings.Builder
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+	for _, r := range s {
+		switch {
+		case isExprNameRune(r):
+			cur.WriteRune(r)
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+func isExprNameRune(r rune) bool {
+	switch {
+	case r >= 'A' && r <= 'Z':
+		return true
+	case r >= 'a' && r <= 'z':
+		return true
+	case r >= '0' && r <= '9':
+		return true
+	case r == '.' || r == '+' || r == '-':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+// Package headers provides a check that source files begin with a
+// recognizable license header, following the same heuristic addlicense
+// uses.
+package headers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// HeaderOptions configures AssertHeaders and TestHeaders.
+type HeaderOptions struct {
+	// SPDXID is the required SPDX license expression, e.g. "MIT" or
+	// "MIT OR Apache-2.0". A file passes the SPDX check if its
+	// SPDX-License-Identifier tag contains this string. Leave empty to
+	// accept any SPDX-License-Identifier tag.
+	SPDXID string
+
+	// CopyrightRegex is the pattern a copyright line must match. If nil, a
+	// default pattern requiring the word "copyright" followed by a year in
+	// YearFormat is used.
+	CopyrightRegex *regexp.Regexp
+
+	// YearFormat is a regexp fragment describing the expected year format,
+	// used to build the default CopyrightRegex. Defaults to "[0-9]{4}".
+	YearFormat string
+
+	// Patterns are additional filename glob patterns to check, on top of
+	// the default "*.go".
+	Patterns []string
+
+	// SkipDirs are additional directory names skipped while walking fsys,
+	// on top of "vendor", "testdata", and ".git", which are always skipped.
+	SkipDirs []string
+
+	// HeaderBytes is the number of bytes from the start of a file to
+	// inspect for a license header. Defaults to 1024.
+	HeaderBytes int
+}
+
+func (o HeaderOptions) withDefaults() HeaderOptions {
+	o.Patterns = append([]string{"*.go"}, o.Patterns...)
+	o.SkipDirs = append([]string{"vendor", "testdata", ".git"}, o.SkipDirs...)
+	if o.HeaderBytes == 0 {
+		o.HeaderBytes = 1024
+	}
+	if o.CopyrightRegex == nil {
+		year := o.YearFormat
+		if year == "" {
+			year = "[0-9]{4}"
+		}
+		o.CopyrightRegex = regexp.MustCompile(`(?i)copyright.*` + year)
+	}
+	return o
+}
+
+var spdxTag = regexp.MustCompile(`(?i)SPDX-License-Identifier:\s*(.+)`)
+
+// TestHeaders calls t.Fatal(err) if any source file under the current
+// directory is missing a recognizable license header.
+func TestHeaders(t *testing.T, opts HeaderOptions) {
+	if err := AssertHeaders(os.DirFS("."), opts); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// AssertHeaders walks fsys, skipping vendor, testdata, .git, and
+// opts.SkipDirs, and returns err != nil naming every file matched by
+// opts.Patterns (default "*.go") whose first opts.HeaderBytes bytes do not
+// contain a recognizable license header: an SPDX-License-Identifier tag
+// satisfying opts.SPDXID, a copyright notice matching opts.CopyrightRegex,
+// or the phrase "mozilla public".
+func AssertHeaders(fsys fs.FS, opts HeaderOptions) error {
+	opts = opts.withDefaults()
+
+	var offending []string
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != "." && isSkipDir(d.Name(), opts.SkipDirs) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !matchesAny(d.Name(), opts.Patterns) {
+			return nil
+		}
+		ok, err := hasHeader(fsys, p, opts)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			offending = append(offending, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(offending) > 0 {
+		return fmt.Errorf("missing license header in %d file(s): %s", len(offending), strings.Join(offending, ", "))
+	}
+	return nil
+}
+
+func hasHeader(fsys fs.FS, p string, opts HeaderOptions) (bool, error) {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	buf := make([]byte, opts.HeaderBytes)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return false, err
+	}
+	head := buf[:n]
+
+	if m := spdxTag.FindSubmatch(head); m != nil {
+		if opts.SPDXID == "" || strings.Contains(string(m[1]), opts.SPDXID) {
+			return true, nil
+		}
+	}
+	if opts.CopyrightRegex.Match(head) {
+		return true, nil
+	}
+	if strings.Contains(strings.ToLower(string(head)), "mozilla public") {
+		return true, nil
+	}
+	return false, nil
+}
+
+func matchesAny(name string, patterns []string) bool {
+	for _, pat := range patterns {
+		if ok, _ := path.Match(pat, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func isSkipDir(name string, skip []string) bool {
+	for _, s := range skip {
+		if name == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package headers
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestAssertHeadersPasses(t *testing.T) {
+	fsys := fstest.MapFS{
+		"good.go": &fstest.MapFile{Data: []byte("// Copyright 2024 Jane Doe\npackage good\n")},
+	}
+	if err := AssertHeaders(fsys, HeaderOptions{}); err != nil {
+		t.Fatalf("AssertHeaders: %v", err)
+	}
+}
+
+func TestAssertHeadersReportsMissing(t *testing.T) {
+	fsys := fstest.MapFS{
+		"good.go":             &fstest.MapFile{Data: []byte("// SPDX-License-Identifier: MIT\npackage good\n")},
+		"bad.go":              &fstest.MapFile{Data: []byte("package bad\n")},
+		"vendor/ignored.go":   &fstest.MapFile{Data: []byte("package ignored\n")},
+		"testdata/ignored.go": &fstest.MapFile{Data: []byte("package ignored\n")},
+		"notes.txt":           &fstest.MapFile{Data: []byte("no header, but not *.go so not checked")},
+	}
+
+	err := AssertHeaders(fsys, HeaderOptions{SPDXID: "MIT"})
+	if err == nil {
+		t.Fatal("expected error for bad.go missing a header")
+	}
+	if !strings.Contains(err.Error(), "bad.go") {
+		t.Errorf("error %q should mention bad.go", err)
+	}
+	for _, skipped := range []string{"vendor/ignored.go", "testdata/ignored.go", "notes.txt", "good.go"} {
+		if strings.Contains(err.Error(), skipped) {
+			t.Errorf("error %q should not mention %s", err, skipped)
+		}
+	}
+}
+
+func TestAssertHeadersCustomPatternsAddToDefault(t *testing.T) {
+	fsys := fstest.MapFS{
+		"script.py": &fstest.MapFile{Data: []byte("no header here")},
+		"bad.go":    &fstest.MapFile{Data: []byte("package bad\n")},
+	}
+
+	err := AssertHeaders(fsys, HeaderOptions{Patterns: []string{"*.py"}})
+	if err == nil {
+		t.Fatal("expected error for files missing a header")
+	}
+	if !strings.Contains(err.Error(), "script.py") {
+		t.Errorf("error %q should mention script.py", err)
+	}
+	if !strings.Contains(err.Error(), "bad.go") {
+		t.Errorf("error %q should still mention bad.go: custom Patterns must add to the *.go default, not replace it", err)
+	}
+}
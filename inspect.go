@@ -0,0 +1,78 @@
+package testlicense
+
+import (
+	"errors"
+	"io/fs"
+
+	"github.com/google/licensecheck"
+)
+
+// Match is one license detected within a Result, reshaped from
+// licensecheck.Match. SPDXID is Name, the specific license identifier
+// licensecheck matched against (e.g. "Apache-2.0", "BSD-3-Clause"); Type is
+// licensecheck's coarse enum (Apache, BSD, ...) and cannot make that
+// distinction on its own.
+type Match struct {
+	Name      string
+	Type      licensecheck.Type
+	SPDXID    string
+	Percent   float64
+	StartByte int
+	EndByte   int
+}
+
+// Result is the raw output of license detection, before any pass/fail
+// policy is applied.
+type Result struct {
+	Filename string
+	Contents []byte
+	Coverage licensecheck.Coverage
+	Matches  []Match
+}
+
+// Inspect searches fsys for a license file and runs license detection on
+// it, returning the raw result without judging whether it satisfies any
+// policy. AssertLicense, AssertLicenseExpr, AssertApprovedLicense, and
+// AssertPolicies are thin policy layers on top of Inspect; callers that need
+// custom assertions or diagnostics can call it directly instead of
+// re-running detection themselves. AssertDependencyLicenses does not use
+// Inspect: it reads each module's license file from the module cache and
+// classifies it with licensecheck directly.
+func Inspect(fsys fs.FS) (*Result, error) {
+	filename, b, err := ReadLicenseFS(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return inspectContents(filename, b)
+}
+
+// inspectContents runs license detection on the already-read contents of
+// filename, without caring how they were read. It backs both Inspect and
+// AssertLicenseDir, which reads through the DirnamesReader-based
+// ReadLicenseDir that predates fs.FS and so can't route through Inspect
+// directly.
+func inspectContents(filename string, b []byte) (*Result, error) {
+	cov, ok := licensecheck.Cover(b, licensecheck.Options{})
+	if !ok {
+		return nil, errors.New("license not found")
+	}
+
+	matches := make([]Match, len(cov.Match))
+	for i, m := range cov.Match {
+		matches[i] = Match{
+			Name:      m.Name,
+			Type:      m.Type,
+			SPDXID:    m.Name,
+			Percent:   m.Percent,
+			StartByte: m.Start,
+			EndByte:   m.End,
+		}
+	}
+
+	return &Result{
+		Filename: filename,
+		Contents: b,
+		Coverage: cov,
+		Matches:  matches,
+	}, nil
+}
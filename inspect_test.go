@@ -0,0 +1,56 @@
+package testlicense
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+const mitLicenseText = `MIT License
+
+Copyright (c) 2024 Jane Doe
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+`
+
+func TestInspectFindsMIT(t *testing.T) {
+	fsys := fstest.MapFS{
+		"LICENSE": &fstest.MapFile{Data: []byte(mitLicenseText)},
+	}
+
+	res, err := Inspect(fsys)
+	if err != nil {
+		t.Fatalf("Inspect: %v", err)
+	}
+	if res.Filename != "LICENSE" {
+		t.Errorf("Filename = %q, want LICENSE", res.Filename)
+	}
+
+	var found bool
+	for _, m := range res.Matches {
+		if m.SPDXID != m.Name {
+			t.Errorf("SPDXID = %q, want %q (Match.Name)", m.SPDXID, m.Name)
+		}
+		if m.Name == "MIT" && m.Percent >= 90 {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected an MIT match at >= 90%%, got %+v", res.Matches)
+	}
+}
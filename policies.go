@@ -0,0 +1,88 @@
+package testlicense
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/google/licensecheck"
+)
+
+// DirPolicy describes the license policy for one directory of a monorepo.
+type DirPolicy struct {
+	// Root is the directory to check. A relative Root is resolved against
+	// the current directory (the package directory under go test), not
+	// against the module root.
+	Root string
+
+	// Want is the license type required in Root.
+	Want licensecheck.Type
+
+	// Percent is the minimum coverage percent required. Defaults to 90.
+	Percent float64
+
+	// Required, when true, fails the policy if Root has no license file at
+	// all. When false, a missing license file in Root is not an error,
+	// which lets a repository declare a policy only for the directories
+	// that happen to carry their own license.
+	Required bool
+}
+
+// AssertPolicies walks each policy's Root, locates its license file, and
+// enforces the policy independently, aggregating every failure into a
+// single error. This lets a repository declare, e.g.,
+// Apache-2.0 at the top level but MIT under examples/ and CC-BY under
+// docs/, which AssertLicense cannot express on its own.
+func AssertPolicies(policies []DirPolicy) error {
+	var errs []error
+	for _, p := range policies {
+		if err := p.assert(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.Root, err))
+		}
+	}
+	return joinErrors(errs)
+}
+
+func (p DirPolicy) assert() error {
+	percent := p.Percent
+	if percent == 0 {
+		percent = 90
+	}
+	if err := AssertLicenseFS(os.DirFS(p.Root), p.Want, percent); err != nil {
+		if errors.Is(err, os.ErrNotExist) && !p.Required {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// multiError aggregates several errors into one, in the style of
+// conform's multi-policy reports.
+type multiError struct {
+	errs []error
+}
+
+func (m *multiError) Error() string {
+	parts := make([]string, len(m.errs))
+	for i, err := range m.errs {
+		parts[i] = err.Error()
+	}
+	return strings.Join(parts, "\n")
+}
+
+func (m *multiError) Unwrap() []error {
+	return m.errs
+}
+
+func joinErrors(errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return &multiError{errs: errs}
+	}
+}
@@ -0,0 +1,40 @@
+package testlicense
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/licensecheck"
+)
+
+func TestAssertPoliciesMissingOptionalPasses(t *testing.T) {
+	dir := t.TempDir()
+	err := AssertPolicies([]DirPolicy{{Root: dir, Want: licensecheck.MIT}})
+	if err != nil {
+		t.Fatalf("AssertPolicies: %v", err)
+	}
+}
+
+func TestAssertPoliciesMissingRequiredFails(t *testing.T) {
+	dir := t.TempDir()
+	err := AssertPolicies([]DirPolicy{{Root: dir, Want: licensecheck.MIT, Required: true}})
+	if err == nil {
+		t.Fatal("expected error for missing required license")
+	}
+}
+
+func TestAssertPoliciesAggregatesFailures(t *testing.T) {
+	dir1 := t.TempDir()
+	dir2 := t.TempDir()
+
+	err := AssertPolicies([]DirPolicy{
+		{Root: dir1, Want: licensecheck.MIT, Required: true},
+		{Root: dir2, Want: licensecheck.MIT, Required: true},
+	})
+	if err == nil {
+		t.Fatal("expected an aggregated error for both missing directories")
+	}
+	if !strings.Contains(err.Error(), dir1) || !strings.Contains(err.Error(), dir2) {
+		t.Errorf("expected both %q and %q in aggregated error, got: %v", dir1, dir2, err)
+	}
+}